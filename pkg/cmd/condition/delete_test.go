@@ -149,6 +149,30 @@ func TestConditionDelete(t *testing.T) {
 			wantError:   true,
 			want:        "--all flag should not have any arguments or flags specified with it",
 		},
+		{
+			name:        "With client dry-run flag",
+			command:     []string{"rm", "condition1", "-n", "ns", "--dry-run=client"},
+			input:       seeds[0],
+			inputStream: nil,
+			wantError:   false,
+			want:        "Conditions deleted: \"condition1\" (dry run)\n",
+		},
+		{
+			name:        "Delete all with server dry-run flag",
+			command:     []string{"delete", "--all", "-n", "ns", "--dry-run=server"},
+			input:       seeds[3],
+			inputStream: nil,
+			wantError:   false,
+			want:        "All Conditions deleted in namespace \"ns\" (dry run)\n",
+		},
+		{
+			name:        "Invalid dry-run value",
+			command:     []string{"rm", "condition1", "-n", "ns", "--dry-run=bogus"},
+			input:       seeds[0],
+			inputStream: nil,
+			wantError:   true,
+			want:        "invalid dry-run value \"bogus\": must be \"client\" or \"server\"",
+		},
 	}
 
 	for _, tp := range testParams {
@@ -29,63 +29,164 @@ import (
 	validate "github.com/tektoncd/cli/pkg/helper/validate"
 	"github.com/tektoncd/cli/pkg/printer"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
+	duckv1beta1 "knative.dev/pkg/apis/duck/v1beta1"
 )
 
 const describeTemplate = `{{decorate "bold" "Name"}}:	{{ .PipelineName }}
-{{decorate "bold" "Namespace"}}:	{{ .Pipeline.Namespace }}
+{{decorate "bold" "Namespace"}}:	{{ .Namespace }}
 
 {{decorate "resources" ""}}{{decorate "underline bold" "Resources\n"}}
-{{- $rl := len .Pipeline.Spec.Resources }}{{ if eq $rl 0 }}
+{{- $rl := len .Resources }}{{ if eq $rl 0 }}
  No resources
 {{- else }}
  NAME	TYPE
-{{- range $i, $r := .Pipeline.Spec.Resources }}
+{{- range $i, $r := .Resources }}
  {{decorate "bullet" $r.Name }}	{{ $r.Type }}
 {{- end }}
 {{- end }}
 
 {{decorate "params" ""}}{{decorate "underline bold" "Params\n"}}
-{{- $l := len .Pipeline.Spec.Params }}{{ if eq $l 0 }}
+{{- $l := len .Params }}{{ if eq $l 0 }}
  No params
 {{- else }}
  NAME	TYPE	DEFAULT VALUE
-{{- range $i, $p := .Pipeline.Spec.Params }}
-{{- if not $p.Default }}
- {{decorate "bullet" $p.Name }}	{{ $p.Type }}	{{ "---" }}
-{{- else }}
-{{- if eq $p.Type "string" }}
- {{decorate "bullet" $p.Name }}	{{ $p.Type }}	{{ $p.Default.StringVal }}
-{{- else }}
- {{decorate "bullet" $p.Name }}	{{ $p.Type }}	{{ $p.Default.ArrayVal }}
+{{- range $i, $p := .Params }}
+ {{decorate "bullet" $p.Name }}	{{ $p.Type }}	{{ $p.Default }}
 {{- end }}
 {{- end }}
+
+{{decorate "workspaces" ""}}{{decorate "underline bold" "Workspaces\n"}}
+{{- $wl := len .Workspaces }}{{ if eq $wl 0 }}
+ No workspaces
+{{- else }}
+ NAME	DESCRIPTION
+{{- range $i, $w := .Workspaces }}
+ {{decorate "bullet" $w.Name }}	{{ $w.Description }}
 {{- end }}
 {{- end }}
 
 {{decorate "tasks" ""}}{{decorate "underline bold" "Tasks\n"}}
-{{- $tl := len .Pipeline.Spec.Tasks }}{{ if eq $tl 0 }}
+{{- $tl := len .Tasks }}{{ if eq $tl 0 }}
  No tasks
 {{- else }}
- NAME	TASKREF	RUNAFTER
-{{- range $i, $t := .Pipeline.Spec.Tasks }}
- {{decorate "bullet" $t.Name }}	{{ $t.TaskRef.Name }}	{{ join $t.RunAfter ", " }}
+ NAME	TASKREF	RUNAFTER	RESULTS
+{{- range $i, $t := .Tasks }}
+ {{decorate "bullet" $t.Name }}	{{ $t.TaskRef }}	{{ join $t.RunAfter ", " }}	{{ join $t.Results ", " }}
+{{- end }}
+{{- end }}
+
+{{- if .CustomTasks }}
+
+{{decorate "customtasks" ""}}{{decorate "underline bold" "Custom Tasks\n"}}
+ NAME	KIND	REF
+{{- range $i, $ct := .CustomTasks }}
+ {{decorate "bullet" $ct.Name }}	{{ $ct.Kind }}	{{ $ct.RefName }}
+{{- end }}
+{{- end }}
+
+{{- if .Conditions }}
+
+{{decorate "conditions" ""}}{{decorate "underline bold" "Conditions\n"}}
+ TASK	CONDITION	STATUS
+{{- range $i, $c := .Conditions }}
+ {{decorate "bullet" $c.Task }}	{{ $c.ConditionRef }}	{{ $c.Status }}
 {{- end }}
 {{- end }}
 
 {{decorate "pipelineruns" ""}}{{decorate "underline bold" "PipelineRuns\n"}}
-{{- $rl := len .PipelineRuns.Items }}{{ if eq $rl 0 }}
+{{- $rl := len .PipelineRuns }}{{ if eq $rl 0 }}
  No pipelineruns
 {{- else }}
  NAME	STARTED	DURATION	STATUS
-{{- range $i, $pr := .PipelineRuns.Items }}
- {{decorate "bullet" $pr.Name }}	{{ formatAge $pr.Status.StartTime $.Params.Time }}	{{ formatDuration $pr.Status.StartTime $pr.Status.CompletionTime }}	{{ formatCondition $pr.Status.Conditions }}
+{{- range $i, $pr := .PipelineRuns }}
+ {{decorate "bullet" $pr.Name }}	{{ formatAge $pr.StartTime $.CliParams.Time }}	{{ formatDuration $pr.StartTime $pr.CompletionTime }}	{{ formatCondition $pr.Conditions }}
 {{- end }}
 {{- end }}
 `
 
+// resourceDesc, paramDesc, workspaceDesc, taskDesc and pipelineRunDesc are a
+// version-agnostic view of the fields the describe template needs, built
+// from either a v1alpha1.Pipeline or a v1beta1.Pipeline so the same template
+// can render both.
+type resourceDesc struct {
+	Name string
+	Type string
+}
+
+type paramDesc struct {
+	Name    string
+	Type    string
+	Default string
+}
+
+type workspaceDesc struct {
+	Name        string
+	Description string
+}
+
+type taskDesc struct {
+	Name     string
+	TaskRef  string
+	RunAfter []string
+	Results  []string
+}
+
+type pipelineRunDesc struct {
+	Name           string
+	StartTime      *metav1.Time
+	CompletionTime *metav1.Time
+	Conditions     duckv1beta1.Conditions
+}
+
+// customTaskDesc describes a PipelineTask whose TaskRef names a non-Tekton
+// Kind, i.e. a Custom Task reconciled by some other controller into a Run.
+type customTaskDesc struct {
+	Name    string
+	Kind    string
+	RefName string
+}
+
+// conditionDesc describes one PipelineTask's guarding Condition and its last
+// ConditionCheck status, as recorded on the most recent PipelineRun.
+type conditionDesc struct {
+	Task         string
+	ConditionRef string
+	Status       string
+}
+
+type describeData struct {
+	PipelineName string
+	Namespace    string
+	Resources    []resourceDesc
+	Params       []paramDesc
+	Workspaces   []workspaceDesc
+	Tasks        []taskDesc
+	CustomTasks  []customTaskDesc
+	Conditions   []conditionDesc
+	PipelineRuns []pipelineRunDesc
+	CliParams    cli.Params
+}
+
+// tektonTaskKinds are the TaskRef kinds that resolve to a Tekton TaskRun
+// rather than a Custom Task Run. An empty Kind defaults to "Task".
+var tektonTaskKinds = map[string]bool{
+	"":            true,
+	"Task":        true,
+	"ClusterTask": true,
+}
+
+// isCustomTask reports whether a PipelineTask's TaskRef names a Custom Task
+// rather than a Tekton Task/ClusterTask. A non-empty APIVersion always means
+// a Custom Task, even when Kind is left as (or spoofed to) "Task" or "" —
+// Tekton's own TaskRef/ClusterTaskRef never set APIVersion.
+func isCustomTask(kind, apiVersion string) bool {
+	return apiVersion != "" || !tektonTaskKinds[kind]
+}
+
 func describeCommand(p cli.Params) *cobra.Command {
 	f := cliopts.NewPrintFlags("describe")
 
@@ -129,6 +230,17 @@ func describePipelineOutput(w io.Writer, p cli.Params, f *cliopts.PrintFlags, na
 		return err
 	}
 
+	if pipeline, err := cs.Tekton.TektonV1beta1().Pipelines(p.Namespace()).Get(name, metav1.GetOptions{}); err == nil {
+		// NOTE: this is required for -o json|yaml to work properly since
+		// tektoncd go client fails to set these; probably a bug
+		pipeline.GetObjectKind().SetGroupVersionKind(
+			schema.GroupVersionKind{
+				Version: "tekton.dev/v1beta1",
+				Kind:    "Pipeline",
+			})
+		return printer.PrintObject(w, pipeline, f)
+	}
+
 	c := cs.Tekton.TektonV1alpha1().Pipelines(p.Namespace())
 
 	task, err := c.Get(name, metav1.GetOptions{})
@@ -153,35 +265,11 @@ func printPipelineDescription(out io.Writer, p cli.Params, pname string) error {
 		return err
 	}
 
-	pipeline, err := cs.Tekton.TektonV1alpha1().Pipelines(p.Namespace()).Get(pname, metav1.GetOptions{})
+	data, err := describeDataFor(cs, p, pname)
 	if err != nil {
 		return err
 	}
 
-	if len(pipeline.Spec.Resources) > 0 {
-		pipeline.Spec.Resources = sortResourcesByTypeAndName(pipeline.Spec.Resources)
-	}
-
-	opts := metav1.ListOptions{
-		LabelSelector: fmt.Sprintf("tekton.dev/pipeline=%s", pname),
-	}
-	pipelineRuns, err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).List(opts)
-	if err != nil {
-		return err
-	}
-
-	var data = struct {
-		Pipeline     *v1alpha1.Pipeline
-		PipelineRuns *v1alpha1.PipelineRunList
-		PipelineName string
-		Params       cli.Params
-	}{
-		Pipeline:     pipeline,
-		PipelineRuns: pipelineRuns,
-		PipelineName: pname,
-		Params:       p,
-	}
-
 	funcMap := template.FuncMap{
 		"formatAge":       formatted.Age,
 		"formatDuration":  formatted.Duration,
@@ -200,6 +288,219 @@ func printPipelineDescription(out io.Writer, p cli.Params, pname string) error {
 	return w.Flush()
 }
 
+// describeDataFor fetches the named pipeline, preferring the v1beta1 API
+// group and falling back to v1alpha1, and converts it (along with its
+// PipelineRuns) into the version-agnostic describeData the template renders.
+func describeDataFor(cs *cli.Clients, p cli.Params, pname string) (*describeData, error) {
+	opts := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("tekton.dev/pipeline=%s", pname),
+	}
+
+	if pipeline, err := cs.Tekton.TektonV1beta1().Pipelines(p.Namespace()).Get(pname, metav1.GetOptions{}); err == nil {
+		pipelineRuns, err := cs.Tekton.TektonV1beta1().PipelineRuns(p.Namespace()).List(opts)
+		if err != nil {
+			return nil, err
+		}
+		return describeDataFromV1beta1(p, pname, pipeline, pipelineRuns), nil
+	}
+
+	pipeline, err := cs.Tekton.TektonV1alpha1().Pipelines(p.Namespace()).Get(pname, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pipelineRuns, err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).List(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return describeDataFromV1alpha1(p, pname, pipeline, pipelineRuns), nil
+}
+
+func describeDataFromV1alpha1(p cli.Params, pname string, pipeline *v1alpha1.Pipeline, runs *v1alpha1.PipelineRunList) *describeData {
+	resources := sortResourcesByTypeAndName(pipeline.Spec.Resources)
+
+	data := &describeData{
+		PipelineName: pname,
+		Namespace:    pipeline.Namespace,
+		CliParams:    p,
+	}
+
+	for _, r := range resources {
+		data.Resources = append(data.Resources, resourceDesc{Name: r.Name, Type: string(r.Type)})
+	}
+
+	for _, param := range pipeline.Spec.Params {
+		data.Params = append(data.Params, paramDesc{Name: param.Name, Type: string(param.Type), Default: paramDefaultString(param.Type, param.Default)})
+	}
+
+	for _, t := range pipeline.Spec.Tasks {
+		var taskRefName, taskRefKind, taskRefAPIVersion string
+		if t.TaskRef != nil {
+			taskRefName = t.TaskRef.Name
+			taskRefKind = string(t.TaskRef.Kind)
+			taskRefAPIVersion = t.TaskRef.APIVersion
+		}
+		if isCustomTask(taskRefKind, taskRefAPIVersion) {
+			data.CustomTasks = append(data.CustomTasks, customTaskDesc{Name: t.Name, Kind: taskRefKind, RefName: taskRefName})
+			continue
+		}
+		data.Tasks = append(data.Tasks, taskDesc{Name: t.Name, TaskRef: taskRefName, RunAfter: t.RunAfter})
+	}
+
+	for _, pr := range runs.Items {
+		data.PipelineRuns = append(data.PipelineRuns, pipelineRunDesc{
+			Name:           pr.Name,
+			StartTime:      pr.Status.StartTime,
+			CompletionTime: pr.Status.CompletionTime,
+			Conditions:     pr.Status.Conditions,
+		})
+	}
+
+	data.Conditions = buildConditionDescs(pipeline, runs.Items)
+
+	return data
+}
+
+// buildConditionDescs describes each PipelineTask's guarding Conditions and
+// their last ConditionCheck status, read off the most recently started
+// PipelineRun. Conditions were retired in favour of WhenExpressions in
+// v1beta1, so this only applies to v1alpha1 pipelines.
+func buildConditionDescs(pipeline *v1alpha1.Pipeline, runs []v1alpha1.PipelineRun) []conditionDesc {
+	checksByTask := map[string][]v1alpha1.PipelineRunConditionCheckStatus{}
+	if latest := latestPipelineRun(runs); latest != nil {
+		for _, trs := range latest.Status.TaskRuns {
+			if trs == nil {
+				continue
+			}
+			for _, cc := range trs.ConditionChecks {
+				if cc == nil {
+					continue
+				}
+				checksByTask[trs.PipelineTaskName] = append(checksByTask[trs.PipelineTaskName], *cc)
+			}
+		}
+	}
+
+	var descs []conditionDesc
+	for _, t := range pipeline.Spec.Tasks {
+		for _, c := range t.Conditions {
+			status := "---"
+			for _, cc := range checksByTask[t.Name] {
+				if cc.ConditionName != c.ConditionRef {
+					continue
+				}
+				if cc.Status != nil && len(cc.Status.Conditions) != 0 {
+					status = string(cc.Status.Conditions[0].Status)
+				}
+				break
+			}
+			descs = append(descs, conditionDesc{Task: t.Name, ConditionRef: c.ConditionRef, Status: status})
+		}
+	}
+
+	return descs
+}
+
+func latestPipelineRun(items []v1alpha1.PipelineRun) *v1alpha1.PipelineRun {
+	var latest *v1alpha1.PipelineRun
+	for i := range items {
+		pr := &items[i]
+		if pr.Status.StartTime == nil {
+			continue
+		}
+		if latest == nil || latest.Status.StartTime == nil || pr.Status.StartTime.After(latest.Status.StartTime.Time) {
+			latest = pr
+		}
+	}
+	return latest
+}
+
+func describeDataFromV1beta1(p cli.Params, pname string, pipeline *v1beta1.Pipeline, runs *v1beta1.PipelineRunList) *describeData {
+	data := &describeData{
+		PipelineName: pname,
+		Namespace:    pipeline.Namespace,
+		CliParams:    p,
+	}
+
+	for _, r := range pipeline.Spec.Resources {
+		data.Resources = append(data.Resources, resourceDesc{Name: r.Name, Type: string(r.Type)})
+	}
+
+	for _, param := range pipeline.Spec.Params {
+		data.Params = append(data.Params, paramDesc{Name: param.Name, Type: string(param.Type), Default: paramDefaultStringV1beta1(param.Type, param.Default)})
+	}
+
+	for _, w := range pipeline.Spec.Workspaces {
+		data.Workspaces = append(data.Workspaces, workspaceDesc{Name: w.Name, Description: w.Description})
+	}
+
+	for _, t := range pipeline.Spec.Tasks {
+		var taskRefName, taskRefKind, taskRefAPIVersion string
+		if t.TaskRef != nil {
+			taskRefName = t.TaskRef.Name
+			taskRefKind = string(t.TaskRef.Kind)
+			taskRefAPIVersion = t.TaskRef.APIVersion
+		}
+		if isCustomTask(taskRefKind, taskRefAPIVersion) {
+			data.CustomTasks = append(data.CustomTasks, customTaskDesc{Name: t.Name, Kind: taskRefKind, RefName: taskRefName})
+			continue
+		}
+		data.Tasks = append(data.Tasks, taskDesc{
+			Name:     t.Name,
+			TaskRef:  taskRefName,
+			RunAfter: t.RunAfter,
+			Results:  taskResultNames(t.TaskSpec),
+		})
+	}
+
+	for _, pr := range runs.Items {
+		data.PipelineRuns = append(data.PipelineRuns, pipelineRunDesc{
+			Name:           pr.Name,
+			StartTime:      pr.Status.StartTime,
+			CompletionTime: pr.Status.CompletionTime,
+			Conditions:     pr.Status.Conditions,
+		})
+	}
+
+	return data
+}
+
+// taskResultNames returns the names of the results declared by an inlined
+// v1beta1 TaskSpec, if any. Results declared on a referenced (non-inlined)
+// Task aren't available without an extra Task lookup, so they're omitted.
+func taskResultNames(ts *v1beta1.EmbeddedTask) []string {
+	if ts == nil {
+		return nil
+	}
+
+	var names []string
+	for _, r := range ts.Results {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+func paramDefaultString(t v1alpha1.ParamType, def *v1alpha1.ArrayOrString) string {
+	if def == nil {
+		return "---"
+	}
+	if t == v1alpha1.ParamTypeString {
+		return def.StringVal
+	}
+	return strings.Join(def.ArrayVal, ", ")
+}
+
+func paramDefaultStringV1beta1(t v1beta1.ParamType, def *v1beta1.ArrayOrString) string {
+	if def == nil {
+		return "---"
+	}
+	if t == v1beta1.ParamTypeString {
+		return def.StringVal
+	}
+	return strings.Join(def.ArrayVal, ", ")
+}
+
 // this will sort the Resource by Type and then by Name
 func sortResourcesByTypeAndName(pres []v1alpha1.PipelineDeclaredResource) []v1alpha1.PipelineDeclaredResource {
 	sort.Slice(pres, func(i, j int) bool {
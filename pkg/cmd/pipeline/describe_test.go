@@ -0,0 +1,200 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1beta1 "knative.dev/pkg/apis/duck/v1beta1"
+)
+
+func TestDescribeDataFromV1alpha1(t *testing.T) {
+	p := &test.Params{}
+
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: "ns"},
+		Spec: v1alpha1.PipelineSpec{
+			Params: []v1alpha1.ParamSpec{
+				{Name: "image", Type: v1alpha1.ParamTypeString, Default: &v1alpha1.ArrayOrString{Type: v1alpha1.ParamTypeString, StringVal: "ubuntu"}},
+			},
+			Tasks: []v1alpha1.PipelineTask{
+				{Name: "build", TaskRef: &v1alpha1.TaskRef{Name: "build-task"}},
+				{
+					Name:       "deploy",
+					TaskRef:    &v1alpha1.TaskRef{Name: "deploy-task"},
+					RunAfter:   []string{"build"},
+					Conditions: []v1alpha1.PipelineTaskCondition{{ConditionRef: "is-main"}},
+				},
+				{Name: "notify", TaskRef: &v1alpha1.TaskRef{Name: "slack-notify", Kind: "Task", APIVersion: "example.dev/v1"}},
+			},
+		},
+	}
+
+	runs := &v1alpha1.PipelineRunList{
+		Items: []v1alpha1.PipelineRun{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "build-run-1"},
+				Status: v1alpha1.PipelineRunStatus{
+					PipelineRunStatusFields: v1alpha1.PipelineRunStatusFields{
+						StartTime: &metav1.Time{Time: time.Unix(100, 0)},
+						TaskRuns: map[string]*v1alpha1.PipelineRunTaskRunStatus{
+							"deploy-tr": {
+								PipelineTaskName: "deploy",
+								ConditionChecks: map[string]*v1alpha1.PipelineRunConditionCheckStatus{
+									"deploy-tr-is-main": {
+										ConditionName: "is-main",
+										Status: &v1alpha1.ConditionCheckStatus{
+											Status: duckv1beta1.Status{
+												Conditions: duckv1beta1.Conditions{{Status: "True"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	data := describeDataFromV1alpha1(p, "build", pipeline, runs)
+
+	if data.Namespace != "ns" {
+		t.Errorf("Namespace = %q, want %q", data.Namespace, "ns")
+	}
+	if len(data.Params) != 1 || data.Params[0].Default != "ubuntu" {
+		t.Errorf("Params = %+v, want a single ubuntu default", data.Params)
+	}
+	if len(data.Tasks) != 2 {
+		t.Fatalf("Tasks = %+v, want 2 tekton tasks (notify should be classified as a custom task)", data.Tasks)
+	}
+	if len(data.CustomTasks) != 1 || data.CustomTasks[0].Name != "notify" {
+		t.Errorf("CustomTasks = %+v, want [notify]", data.CustomTasks)
+	}
+	if len(data.PipelineRuns) != 1 || data.PipelineRuns[0].Name != "build-run-1" {
+		t.Errorf("PipelineRuns = %+v, want [build-run-1]", data.PipelineRuns)
+	}
+	if len(data.Conditions) != 1 || data.Conditions[0].Status != "True" {
+		t.Errorf("Conditions = %+v, want deploy/is-main at status True", data.Conditions)
+	}
+}
+
+func TestDescribeDataFromV1beta1(t *testing.T) {
+	p := &test.Params{}
+
+	pipeline := &v1beta1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: "ns"},
+		Spec: v1beta1.PipelineSpec{
+			Params: []v1beta1.ParamSpec{
+				{Name: "image", Type: v1beta1.ParamTypeString, Default: &v1beta1.ArrayOrString{Type: v1beta1.ParamTypeString, StringVal: "ubuntu"}},
+			},
+			Workspaces: []v1beta1.PipelineWorkspaceDeclaration{
+				{Name: "source", Description: "shared checkout"},
+			},
+			Tasks: []v1beta1.PipelineTask{
+				{
+					Name:    "build",
+					TaskRef: &v1beta1.TaskRef{Name: "build-task"},
+					TaskSpec: &v1beta1.EmbeddedTask{
+						TaskSpec: v1beta1.TaskSpec{
+							Results: []v1beta1.TaskResult{{Name: "image-digest"}},
+						},
+					},
+				},
+				{Name: "notify", TaskRef: &v1beta1.TaskRef{Name: "slack-notify", Kind: "Task", APIVersion: "example.dev/v1"}},
+			},
+		},
+	}
+
+	runs := &v1beta1.PipelineRunList{
+		Items: []v1beta1.PipelineRun{
+			{ObjectMeta: metav1.ObjectMeta{Name: "build-run-1"}},
+		},
+	}
+
+	data := describeDataFromV1beta1(p, "build", pipeline, runs)
+
+	if len(data.Workspaces) != 1 || data.Workspaces[0].Name != "source" {
+		t.Errorf("Workspaces = %+v, want [source]", data.Workspaces)
+	}
+	if len(data.Tasks) != 1 || len(data.Tasks[0].Results) != 1 || data.Tasks[0].Results[0] != "image-digest" {
+		t.Errorf("Tasks = %+v, want build task with result image-digest", data.Tasks)
+	}
+	if len(data.CustomTasks) != 1 || data.CustomTasks[0].Name != "notify" {
+		t.Errorf("CustomTasks = %+v, want [notify]", data.CustomTasks)
+	}
+	if len(data.Conditions) != 0 {
+		t.Errorf("Conditions = %+v, want none (v1beta1 dropped Conditions for WhenExpressions)", data.Conditions)
+	}
+	if len(data.PipelineRuns) != 1 || data.PipelineRuns[0].Name != "build-run-1" {
+		t.Errorf("PipelineRuns = %+v, want [build-run-1]", data.PipelineRuns)
+	}
+}
+
+func TestBuildConditionDescsNilStatus(t *testing.T) {
+	pipeline := &v1alpha1.Pipeline{
+		Spec: v1alpha1.PipelineSpec{
+			Tasks: []v1alpha1.PipelineTask{
+				{Name: "deploy", Conditions: []v1alpha1.PipelineTaskCondition{{ConditionRef: "is-main"}}},
+			},
+		},
+	}
+
+	runs := []v1alpha1.PipelineRun{
+		{
+			Status: v1alpha1.PipelineRunStatus{
+				PipelineRunStatusFields: v1alpha1.PipelineRunStatusFields{
+					StartTime: &metav1.Time{Time: time.Unix(100, 0)},
+					TaskRuns: map[string]*v1alpha1.PipelineRunTaskRunStatus{
+						"deploy-tr": {
+							PipelineTaskName: "deploy",
+							ConditionChecks: map[string]*v1alpha1.PipelineRunConditionCheckStatus{
+								// A guard that hasn't executed yet: ConditionName is
+								// set but Status is still nil.
+								"deploy-tr-is-main": {ConditionName: "is-main", Status: nil},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	descs := buildConditionDescs(pipeline, runs)
+
+	if len(descs) != 1 || descs[0].Status != "---" {
+		t.Errorf("buildConditionDescs() = %+v, want a single pending condition", descs)
+	}
+}
+
+func TestTaskResultNames(t *testing.T) {
+	if got := taskResultNames(nil); got != nil {
+		t.Errorf("taskResultNames(nil) = %v, want nil", got)
+	}
+
+	ts := &v1beta1.EmbeddedTask{TaskSpec: v1beta1.TaskSpec{
+		Results: []v1beta1.TaskResult{{Name: "a"}, {Name: "b"}},
+	}}
+	got := taskResultNames(ts)
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("taskResultNames() = %v, want [a b]", got)
+	}
+}
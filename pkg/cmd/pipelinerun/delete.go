@@ -0,0 +1,127 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/helper/deleter"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deleteCommand(p cli.Params) *cobra.Command {
+	var forceDelete bool
+	var deleteAll bool
+	var dryRun string
+
+	eg := `Delete a PipelineRun of name 'foo' in namespace 'bar':
+
+	tkn pipelinerun delete foo -n bar
+
+or
+
+	tkn pr rm foo -n bar
+`
+
+	c := &cobra.Command{
+		Use:          "delete",
+		Aliases:      []string{"rm"},
+		Short:        "Delete pipelineruns in a namespace",
+		Example:      eg,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if deleteAll && len(args) != 0 {
+				return fmt.Errorf("--all flag should not have any arguments or flags specified with it")
+			}
+
+			strategy, err := deleter.ParseDryRunStrategy(dryRun)
+			if err != nil {
+				return err
+			}
+
+			cs, err := p.Clients()
+			if err != nil {
+				return fmt.Errorf("failed to create tekton client: %v", err)
+			}
+
+			if _, err := cs.Kube.CoreV1().Namespaces().Get(p.Namespace(), metav1.GetOptions{}); err != nil {
+				return err
+			}
+
+			d := &deleter.Deleter{
+				Kind: "pipelinerun",
+				List: func(opts metav1.ListOptions) ([]string, error) {
+					runs, err := cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).List(opts)
+					if err != nil {
+						return nil, err
+					}
+					names := make([]string, 0, len(runs.Items))
+					for _, r := range runs.Items {
+						names = append(names, r.Name)
+					}
+					return names, nil
+				},
+				Delete: func(name string, opts *metav1.DeleteOptions) error {
+					return cs.Tekton.TektonV1alpha1().PipelineRuns(p.Namespace()).Delete(name, opts)
+				},
+				DryRun: strategy,
+			}
+
+			out := cmd.OutOrStdout()
+
+			if deleteAll {
+				ok, err := d.Confirm(cmd, p.Namespace(), nil, forceDelete)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return fmt.Errorf("canceled deleting all pipelineruns in namespace %q", p.Namespace())
+				}
+				if err := d.DeleteAll(metav1.ListOptions{}); err != nil {
+					return err
+				}
+				fmt.Fprint(out, d.WithDryRunSuffix(d.DoneMessage(p.Namespace(), nil)))
+				return nil
+			}
+
+			if len(args) == 0 {
+				return fmt.Errorf("must provide pipelinerun name(s) to delete, or use --all")
+			}
+
+			ok, err := d.Confirm(cmd, p.Namespace(), args, forceDelete)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("canceled deleting pipelinerun %q", args[0])
+			}
+
+			deleted, err := d.DeleteNames(args)
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(out, d.WithDryRunSuffix(d.DoneMessage(p.Namespace(), deleted)))
+			return nil
+		},
+	}
+
+	c.Flags().BoolVarP(&forceDelete, "force", "f", false, "Whether to force deletion (default: false)")
+	c.Flags().BoolVarP(&deleteAll, "all", "", false, "Delete all pipelineruns in a namespace (default: false)")
+	c.Flags().StringVarP(&dryRun, "dry-run", "", "", "Preview the pipelineruns that would be deleted without deleting them: \"client\" prints locally, \"server\" validates against the apiserver")
+
+	return c
+}
@@ -15,8 +15,11 @@
 package taskrun
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -25,10 +28,20 @@ import (
 	"github.com/tektoncd/cli/pkg/helper/pods"
 	"github.com/tektoncd/cli/pkg/helper/pods/stream"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
-	"knative.dev/pkg/apis/duck/v1beta1"
+	duckv1beta1 "knative.dev/pkg/apis/duck/v1beta1"
+)
+
+// sidecarPrefix marks a pod container as a sidecar rather than a step.
+// resultsSidecarContainerName is the reserved name of the sidecar that
+// tails the task's declared results and reports them on stdout; it's
+// neither a step nor a user sidecar and is handled on its own.
+const (
+	sidecarPrefix               = "sidecar-"
+	resultsSidecarContainerName = "tekton-log-results"
 )
 
 type step struct {
@@ -41,6 +54,46 @@ func (s *step) hasStarted() bool {
 	return s.state.Waiting == nil
 }
 
+// trResource is a version-agnostic view of the fields LogReader needs off a
+// TaskRun, so that the rest of the read path doesn't care whether it was
+// handed a v1alpha1.TaskRun or a v1beta1.TaskRun.
+type trResource struct {
+	name        string
+	labels      map[string]string
+	taskRefName string
+	podName     string
+	started     bool
+	conditions  duckv1beta1.Conditions
+}
+
+func trResourceFromV1alpha1(tr *v1alpha1.TaskRun) *trResource {
+	res := &trResource{
+		name:       tr.Name,
+		labels:     tr.Labels,
+		podName:    tr.Status.PodName,
+		started:    tr.HasStarted(),
+		conditions: tr.Status.Conditions,
+	}
+	if tr.Spec.TaskRef != nil {
+		res.taskRefName = tr.Spec.TaskRef.Name
+	}
+	return res
+}
+
+func trResourceFromV1beta1(tr *v1beta1.TaskRun) *trResource {
+	res := &trResource{
+		name:       tr.Name,
+		labels:     tr.Labels,
+		podName:    tr.Status.PodName,
+		started:    tr.HasStarted(),
+		conditions: tr.Status.Conditions,
+	}
+	if tr.Spec.TaskRef != nil {
+		res.taskRefName = tr.Spec.TaskRef.Name
+	}
+	return res
+}
+
 type LogReader struct {
 	Task     string
 	Run      string
@@ -52,39 +105,79 @@ type LogReader struct {
 	AllSteps bool
 	Stream   *cli.Stream
 	Steps    []string
+
+	// AllSidecars and Sidecars are the --sidecars and --sidecar=<name>
+	// flags: like AllSteps/Steps, but selecting from the pod's sidecar
+	// containers instead of its steps.
+	AllSidecars bool
+	Sidecars    []string
+
+	// WithConditions makes readLogs discover the ConditionChecks guarding
+	// this TaskRun (via its parent PipelineRun status) and stream their
+	// pod logs ahead of the TaskRun's own step logs.
+	WithConditions bool
+
+	// Prefix is the requested value of --prefix: "none", "step", "task" or
+	// "full". LogReader doesn't format with it itself; the CLI printer
+	// reads it off the command to disambiguate interleaved step output.
+	Prefix string
+}
+
+// conditionCheck is a guarding Condition's last execution against a
+// TaskRun, as recorded on the parent PipelineRun's status.
+type conditionCheck struct {
+	name          string
+	conditionName string
+	podName       string
 }
 
 func (lr *LogReader) Read() (<-chan log.Log, <-chan error, error) {
 	tkn := lr.Clients.Tekton
-	tr, err := tkn.TektonV1alpha1().TaskRuns(lr.Ns).Get(lr.Run, metav1.GetOptions{})
-	if err != nil {
-		return nil, nil, fmt.Errorf("%s: %s", msgTRNotFoundErr, err)
+
+	if trV1beta1, err := tkn.TektonV1beta1().TaskRuns(lr.Ns).Get(lr.Run, metav1.GetOptions{}); err == nil {
+		res := trResourceFromV1beta1(trV1beta1)
+		lr.formTaskName(res)
+		return lr.readLogs(res)
+	}
+
+	if tr, err := tkn.TektonV1alpha1().TaskRuns(lr.Ns).Get(lr.Run, metav1.GetOptions{}); err == nil {
+		res := trResourceFromV1alpha1(tr)
+		lr.formTaskName(res)
+		return lr.readLogs(res)
 	}
 
-	lr.formTaskName(tr)
+	// lr.Run may not name a TaskRun at all: a PipelineTask can reference a
+	// Custom Task (an arbitrary apiVersion/kind reconciler), which surfaces
+	// as a Run rather than a TaskRun and has no step containers of its own.
+	if run, err := tkn.TektonV1alpha1().Runs(lr.Ns).Get(lr.Run, metav1.GetOptions{}); err == nil {
+		if lr.Task == "" {
+			lr.Task = run.Name
+		}
+		return lr.readRunLogs(run)
+	}
 
-	return lr.readLogs(tr)
+	return nil, nil, fmt.Errorf("%s: %s", msgTRNotFoundErr, lr.Run)
 }
 
-func (lr *LogReader) readLogs(tr *v1alpha1.TaskRun) (<-chan log.Log, <-chan error, error) {
+func (lr *LogReader) readLogs(tr *trResource) (<-chan log.Log, <-chan error, error) {
 	if lr.Follow {
 		return lr.readLiveLogs()
 	}
 	return lr.readAvailableLogs(tr)
 }
 
-func (lr *LogReader) formTaskName(tr *v1alpha1.TaskRun) {
+func (lr *LogReader) formTaskName(tr *trResource) {
 	if lr.Task != "" {
 		return
 	}
 
-	if name, ok := tr.Labels["tekton.dev/pipelineTask"]; ok {
+	if name, ok := tr.labels["tekton.dev/pipelineTask"]; ok {
 		lr.Task = name
 		return
 	}
 
-	if tr.Spec.TaskRef != nil {
-		lr.Task = tr.Spec.TaskRef.Name
+	if tr.taskRefName != "" {
+		lr.Task = tr.taskRefName
 		return
 	}
 
@@ -98,55 +191,172 @@ func (lr *LogReader) readLiveLogs() (<-chan log.Log, <-chan error, error) {
 	}
 
 	var (
-		podName = tr.Status.PodName
+		podName = tr.podName
 		kube    = lr.Clients.Kube
 	)
 
 	p := pods.New(podName, lr.Ns, kube, lr.Streamer)
 	pod, err := p.Wait()
 	if err != nil {
-		return nil, nil, errors.New(fmt.Sprintf("task %s failed: %s. Run tkn tr desc %s for more details.", lr.Task, strings.TrimSpace(err.Error()), tr.Name))
+		return nil, nil, errors.New(fmt.Sprintf("task %s failed: %s. Run tkn tr desc %s for more details.", lr.Task, strings.TrimSpace(err.Error()), tr.name))
 	}
 
-	steps := filterSteps(pod, lr.AllSteps, lr.Steps)
-	logC, errC := lr.readStepsLogs(steps, p, lr.Follow)
+	containers := append(filterSteps(pod, lr.AllSteps, lr.Steps), filterSidecars(pod, lr.AllSidecars, lr.Sidecars)...)
+	logC, errC := lr.readStepsLogsWithConditions(tr, containers, p, lr.Follow)
+	logC, errC = lr.withResultsLog(pod, p, logC, errC)
 	return logC, errC, err
 }
 
-func (lr *LogReader) readAvailableLogs(tr *v1alpha1.TaskRun) (<-chan log.Log, <-chan error, error) {
-	if !tr.HasStarted() {
+func (lr *LogReader) readAvailableLogs(tr *trResource) (<-chan log.Log, <-chan error, error) {
+	if !tr.started {
 		return nil, nil, fmt.Errorf("task %s has not started yet", lr.Task)
 	}
 
 	//Check if taskrun failed on start up
-	if err := hasTaskRunFailed(tr.Status.Conditions, lr.Task); err != nil {
+	if err := hasTaskRunFailed(tr.conditions, lr.Task); err != nil {
 		if lr.Stream != nil {
 			fmt.Fprintf(lr.Stream.Err, "%s\n", err.Error())
 		}
 		return nil, nil, err
 	}
 
-	if tr.Status.PodName == "" {
-		return nil, nil, fmt.Errorf("pod for taskrun %s not available yet", tr.Name)
+	if tr.podName == "" {
+		return nil, nil, fmt.Errorf("pod for taskrun %s not available yet", tr.name)
 	}
 
 	var (
 		kube    = lr.Clients.Kube
-		podName = tr.Status.PodName
+		podName = tr.podName
 	)
 
 	p := pods.New(podName, lr.Ns, kube, lr.Streamer)
 	pod, err := p.Get()
 	if err != nil {
-		return nil, nil, errors.New(fmt.Sprintf("task %s failed: %s. Run tkn tr desc %s for more details.", lr.Task, strings.TrimSpace(err.Error()), tr.Name))
+		return nil, nil, errors.New(fmt.Sprintf("task %s failed: %s. Run tkn tr desc %s for more details.", lr.Task, strings.TrimSpace(err.Error()), tr.name))
 	}
 
-	steps := filterSteps(pod, lr.AllSteps, lr.Steps)
-	logC, errC := lr.readStepsLogs(steps, p, lr.Follow)
+	containers := append(filterSteps(pod, lr.AllSteps, lr.Steps), filterSidecars(pod, lr.AllSidecars, lr.Sidecars)...)
+	logC, errC := lr.readStepsLogsWithConditions(tr, containers, p, lr.Follow)
+	logC, errC = lr.withResultsLog(pod, p, logC, errC)
 	return logC, errC, nil
 }
 
-func (lr *LogReader) readStepsLogs(steps []*step, pod *pods.Pod, follow bool) (<-chan log.Log, <-chan error) {
+// readStepsLogsWithConditions streams the ConditionChecks guarding tr (when
+// WithConditions is set and any are found) ahead of its own step logs.
+func (lr *LogReader) readStepsLogsWithConditions(tr *trResource, steps []*step, pod *pods.Pod, follow bool) (<-chan log.Log, <-chan error) {
+	if !lr.WithConditions {
+		return lr.readStepsLogs(steps, pod, follow)
+	}
+
+	ccs := lr.findConditionChecks(tr)
+	if len(ccs) == 0 {
+		return lr.readStepsLogs(steps, pod, follow)
+	}
+
+	readers := make([]func() (<-chan log.Log, <-chan error), 0, len(ccs)+1)
+	for _, cc := range ccs {
+		cc := cc
+		readers = append(readers, func() (<-chan log.Log, <-chan error) {
+			return lr.readConditionCheckLogs(cc)
+		})
+	}
+	readers = append(readers, func() (<-chan log.Log, <-chan error) {
+		return lr.readStepsLogs(steps, pod, follow)
+	})
+
+	return mergeSequentialLogs(readers...)
+}
+
+// findConditionChecks looks up tr's parent PipelineRun and returns the
+// ConditionChecks recorded against it, if any.
+func (lr *LogReader) findConditionChecks(tr *trResource) []conditionCheck {
+	prName, ok := tr.labels["tekton.dev/pipelineRun"]
+	if !ok {
+		return nil
+	}
+
+	pr, err := lr.Clients.Tekton.TektonV1alpha1().PipelineRuns(lr.Ns).Get(prName, metav1.GetOptions{})
+	if err != nil {
+		return nil
+	}
+
+	trStatus, ok := pr.Status.TaskRuns[tr.name]
+	if !ok || trStatus == nil {
+		return nil
+	}
+
+	var ccs []conditionCheck
+	for ccName, cc := range trStatus.ConditionChecks {
+		if cc == nil || cc.Status == nil {
+			continue
+		}
+		ccs = append(ccs, conditionCheck{name: ccName, conditionName: cc.ConditionName, podName: cc.Status.PodName})
+	}
+
+	sort.Slice(ccs, func(i, j int) bool { return ccs[i].conditionName < ccs[j].conditionName })
+	return ccs
+}
+
+// readConditionCheckLogs streams the init containers and started step
+// containers of a ConditionCheck's pod (sidecars are not read), tagging each
+// log line with the guarding condition's name.
+func (lr *LogReader) readConditionCheckLogs(cc conditionCheck) (<-chan log.Log, <-chan error) {
+	if cc.podName == "" {
+		logC := make(chan log.Log)
+		close(logC)
+		return logC, nil
+	}
+
+	p := pods.New(cc.podName, lr.Ns, lr.Clients.Kube, lr.Streamer)
+	pod, err := p.Get()
+	if err != nil {
+		logC := make(chan log.Log)
+		errC := make(chan error, 1)
+		close(logC)
+		errC <- fmt.Errorf("failed to get pod for condition %s: %s", cc.conditionName, err)
+		close(errC)
+		return logC, errC
+	}
+
+	steps := filterSteps(pod, true, nil)
+	logC, errC := lr.readStepsLogs(steps, p, false)
+	return relabelConditionLogs(cc.conditionName, logC, errC)
+}
+
+func relabelConditionLogs(conditionName string, logC <-chan log.Log, errC <-chan error) (<-chan log.Log, <-chan error) {
+	outLogC := make(chan log.Log)
+	outErrC := make(chan error)
+
+	go func() {
+		defer close(outLogC)
+		defer close(outErrC)
+
+		for logC != nil || errC != nil {
+			select {
+			case l, ok := <-logC:
+				if !ok {
+					logC = nil
+					continue
+				}
+				l.Step = fmt.Sprintf("condition:%s:%s", conditionName, l.Step)
+				outLogC <- l
+			case e, ok := <-errC:
+				if !ok {
+					errC = nil
+					continue
+				}
+				outErrC <- e
+			}
+		}
+	}()
+
+	return outLogC, outErrC
+}
+
+// mergeSequentialLogs drains each reader's logs and errors in turn, so
+// earlier streams (e.g. ConditionChecks) finish before later ones (the
+// TaskRun's own steps) start.
+func mergeSequentialLogs(readers ...func() (<-chan log.Log, <-chan error)) (<-chan log.Log, <-chan error) {
 	logC := make(chan log.Log)
 	errC := make(chan error)
 
@@ -154,48 +364,352 @@ func (lr *LogReader) readStepsLogs(steps []*step, pod *pods.Pod, follow bool) (<
 		defer close(logC)
 		defer close(errC)
 
-		for _, step := range steps {
-			if !follow && !step.hasStarted() {
-				continue
-			}
-
-			container := pod.Container(step.container)
-			podC, perrC, err := container.LogReader(follow).Read()
-			if err != nil {
-				errC <- fmt.Errorf("error in getting logs for step %s: %s", step.name, err)
-				continue
-			}
-
-			for podC != nil || perrC != nil {
+		for _, read := range readers {
+			lc, ec := read()
+			for lc != nil || ec != nil {
 				select {
-				case l, ok := <-podC:
+				case l, ok := <-lc:
 					if !ok {
-						podC = nil
-						logC <- log.Log{Task: lr.Task, Step: step.name, Log: "EOFLOG"}
+						lc = nil
 						continue
 					}
-					logC <- log.Log{Task: lr.Task, Step: step.name, Log: l.Log}
-
-				case e, ok := <-perrC:
+					logC <- l
+				case e, ok := <-ec:
 					if !ok {
-						perrC = nil
+						ec = nil
 						continue
 					}
+					errC <- e
+				}
+			}
+		}
+	}()
+
+	return logC, errC
+}
 
-					errC <- fmt.Errorf("failed to get logs for %s: %s", step.name, e)
+// withResultsLog appends a final synthetic log.Log to logC once it drains,
+// holding the task's declared results, when pod has a results sidecar.
+// Without this, seeing a task's results means a separate `describe` call.
+func (lr *LogReader) withResultsLog(pod *corev1.Pod, p *pods.Pod, logC <-chan log.Log, errC <-chan error) (<-chan log.Log, <-chan error) {
+	resultsContainer := getResultsContainer(pod)
+	if resultsContainer == "" {
+		return logC, errC
+	}
+
+	outLogC := make(chan log.Log)
+	outErrC := make(chan error)
+
+	go func() {
+		defer close(outLogC)
+		defer close(outErrC)
+
+		for logC != nil || errC != nil {
+			select {
+			case l, ok := <-logC:
+				if !ok {
+					logC = nil
+					continue
 				}
+				outLogC <- l
+			case e, ok := <-errC:
+				if !ok {
+					errC = nil
+					continue
+				}
+				outErrC <- e
 			}
+		}
 
-			if err := container.Status(); err != nil {
-				errC <- err
-				return
+		results, err := readResultsPayload(resultsContainer, p)
+		if err != nil {
+			outErrC <- fmt.Errorf("failed to read task results: %s", err)
+			return
+		}
+		if results == "" {
+			return
+		}
+		outLogC <- log.Log{Task: lr.Task, Step: "results", Log: results}
+	}()
+
+	return outLogC, outErrC
+}
+
+// readResultsPayload reads the results sidecar's full output and keeps only
+// the name=value lines it emits for each declared task result.
+func readResultsPayload(containerName string, p *pods.Pod) (string, error) {
+	container := p.Container(containerName)
+	podC, perrC, err := container.LogReader(false).Read()
+	if err != nil {
+		return "", err
+	}
+
+	var lines []string
+	for podC != nil || perrC != nil {
+		select {
+		case l, ok := <-podC:
+			if !ok {
+				podC = nil
+				continue
+			}
+			if strings.Contains(l.Log, "=") {
+				lines = append(lines, l.Log)
+			}
+		case e, ok := <-perrC:
+			if !ok {
+				perrC = nil
+				continue
+			}
+			return "", e
+		}
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// runExtraFields is the subset of a Run's Status.ExtraFields that custom
+// task controllers commonly populate with the pod backing their execution.
+// It's best-effort: most custom tasks don't run a pod at all.
+type runExtraFields struct {
+	PodName string `json:"podName"`
+}
+
+// readRunLogs services a Custom Task's Run: since a Run has no steps of its
+// own, its "logs" are the message history of its Status.Conditions, emitted
+// as synthetic log lines. If the controller recorded a backing pod, either
+// in ExtraFields or in the conventional annotation, its logs are streamed
+// too using the same pod machinery as a regular TaskRun.
+func (lr *LogReader) readRunLogs(run *v1alpha1.Run) (<-chan log.Log, <-chan error, error) {
+	logC := make(chan log.Log)
+	errC := make(chan error)
+
+	go func() {
+		defer close(logC)
+		defer close(errC)
+
+		for _, c := range run.Status.Conditions {
+			if c.Message == "" {
+				continue
+			}
+			logC <- log.Log{Task: lr.Task, Step: run.Name, Log: c.Message}
+		}
+		logC <- log.Log{Task: lr.Task, Step: run.Name, Log: "EOFLOG"}
+
+		podName := runPodName(run)
+		if podName == "" {
+			return
+		}
+
+		p := pods.New(podName, lr.Ns, lr.Clients.Kube, lr.Streamer)
+		pod, err := p.Get()
+		if err != nil {
+			errC <- fmt.Errorf("failed to get pod %s for run %s: %s", podName, run.Name, err)
+			return
+		}
+
+		steps := filterSteps(pod, lr.AllSteps, lr.Steps)
+		stepLogC, stepErrC := lr.readStepsLogs(steps, p, lr.Follow)
+		for stepLogC != nil || stepErrC != nil {
+			select {
+			case l, ok := <-stepLogC:
+				if !ok {
+					stepLogC = nil
+					continue
+				}
+				logC <- l
+			case e, ok := <-stepErrC:
+				if !ok {
+					stepErrC = nil
+					continue
+				}
+				errC <- e
 			}
 		}
 	}()
 
+	return logC, errC, nil
+}
+
+const runPodNameAnnotation = "custom.tekton.dev/podName"
+
+func runPodName(run *v1alpha1.Run) string {
+	if len(run.Status.ExtraFields.Raw) > 0 {
+		var extra runExtraFields
+		if err := json.Unmarshal(run.Status.ExtraFields.Raw, &extra); err == nil && extra.PodName != "" {
+			return extra.PodName
+		}
+	}
+
+	return run.Annotations[runPodNameAnnotation]
+}
+
+// reorderWindow bounds how long the merger waits, after the last line it
+// received, for an out-of-order line from a slower stream before flushing
+// what it has.
+const reorderWindow = 50 * time.Millisecond
+
+// reorderBufferSize bounds how many pending lines the merger holds at once,
+// so a stalled producer can't grow the buffer without limit.
+const reorderBufferSize = 64
+
+// streamLine is one log line tagged with its source step, a per-step
+// monotonic sequence number (the tiebreaker when two lines share a
+// timestamp or neither has one) and the wall-clock time parsed from the
+// kubelet log prefix, used to interleave concurrent steps in real time.
+type streamLine struct {
+	log log.Log
+	seq int
+	ts  time.Time
+}
+
+// readStepsLogs fans out one goroutine per step (when not following, per
+// step that has already started) so that
+// concurrent steps (and sidecars) stream as they happen rather than one
+// fully draining before the next starts, then fans the lines back in
+// through a small reordering merge so near-simultaneous lines from
+// different steps still come out close to timestamp order.
+func (lr *LogReader) readStepsLogs(steps []*step, pod *pods.Pod, follow bool) (<-chan log.Log, <-chan error) {
+	logC := make(chan log.Log)
+	errC := make(chan error)
+	linesC := make(chan streamLine)
+
+	var wg sync.WaitGroup
+	for _, s := range steps {
+		if !follow && !s.hasStarted() {
+			continue
+		}
+		wg.Add(1)
+		go lr.streamStepLogs(s, pod, follow, linesC, errC, &wg)
+	}
+
+	go func() {
+		wg.Wait()
+		close(linesC)
+	}()
+
+	go func() {
+		defer close(logC)
+		defer close(errC)
+		mergeReorderedLines(linesC, logC)
+	}()
+
 	return logC, errC
 }
 
+// streamStepLogs reads one step's container logs and forwards each line,
+// annotated for reordering, onto the shared linesC. It reports its own
+// errors onto errC but does not stop sibling steps' goroutines.
+func (lr *LogReader) streamStepLogs(s *step, pod *pods.Pod, follow bool, linesC chan<- streamLine, errC chan<- error, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	container := pod.Container(s.container)
+	podC, perrC, err := container.LogReader(follow).Read()
+	if err != nil {
+		errC <- fmt.Errorf("error in getting logs for step %s: %s", s.name, err)
+		return
+	}
+
+	seq := 0
+	var lastTs time.Time
+	for podC != nil || perrC != nil {
+		select {
+		case l, ok := <-podC:
+			if !ok {
+				podC = nil
+				// EOFLOG must sort after every real line this step emitted,
+				// so it carries the step's own last timestamp (not the zero
+				// value, which would sort ahead of any non-zero timestamp
+				// landing in the same reorder batch) plus a seq one past the
+				// last real line's, breaking the tie in its favor.
+				seq++
+				linesC <- streamLine{log: log.Log{Task: lr.Task, Step: s.name, Log: "EOFLOG"}, seq: seq, ts: lastTs}
+				continue
+			}
+			seq++
+			ts, text := parseKubeletTimestamp(l.Log)
+			lastTs = ts
+			linesC <- streamLine{log: log.Log{Task: lr.Task, Step: s.name, Log: text}, seq: seq, ts: ts}
+
+		case e, ok := <-perrC:
+			if !ok {
+				perrC = nil
+				continue
+			}
+			errC <- fmt.Errorf("failed to get logs for %s: %s", s.name, e)
+		}
+	}
+
+	if err := container.Status(); err != nil {
+		errC <- err
+	}
+}
+
+// mergeReorderedLines drains linesC, batching lines for up to reorderWindow
+// (or until reorderBufferSize is reached) and emitting each batch sorted by
+// timestamp, so concurrent steps interleave close to real time instead of
+// in step declaration order. logC is unbuffered, so a slow consumer applies
+// backpressure all the way back to the per-step readers instead of this
+// buffer growing without bound.
+func mergeReorderedLines(linesC <-chan streamLine, logC chan<- log.Log) {
+	buf := make([]streamLine, 0, reorderBufferSize)
+	timer := time.NewTimer(reorderWindow)
+	defer timer.Stop()
+
+	flush := func() {
+		if len(buf) == 0 {
+			return
+		}
+		sort.SliceStable(buf, func(i, j int) bool {
+			if !buf[i].ts.Equal(buf[j].ts) {
+				return buf[i].ts.Before(buf[j].ts)
+			}
+			return buf[i].seq < buf[j].seq
+		})
+		for _, l := range buf {
+			logC <- l.log
+		}
+		buf = buf[:0]
+	}
+
+	for {
+		select {
+		case l, ok := <-linesC:
+			if !ok {
+				flush()
+				return
+			}
+			buf = append(buf, l)
+			if len(buf) >= reorderBufferSize {
+				flush()
+			}
+			timer.Reset(reorderWindow)
+
+		case <-timer.C:
+			flush()
+			timer.Reset(reorderWindow)
+		}
+	}
+}
+
+// parseKubeletTimestamp splits a kubelet-prefixed log line (produced when
+// PodLogOptions.Timestamps is requested) into its RFC3339Nano timestamp and
+// the remaining text. Lines without a recognizable prefix are returned
+// as-is with a zero time, so in a merge batch they sort ahead of every
+// timestamped line and among themselves by per-step sequence number.
+func parseKubeletTimestamp(line string) (time.Time, string) {
+	parts := strings.SplitN(line, " ", 2)
+	if len(parts) != 2 {
+		return time.Time{}, line
+	}
+
+	ts, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return time.Time{}, line
+	}
+
+	return ts, parts[1]
+}
+
 func filterSteps(pod *corev1.Pod, allSteps bool, stepsGiven []string) []*step {
 	steps := []*step{}
 	stepsInPod := getSteps(pod)
@@ -223,6 +737,34 @@ func filterSteps(pod *corev1.Pod, allSteps bool, stepsGiven []string) []*step {
 	return steps
 }
 
+// filterSidecars mirrors filterSteps but selects from the pod's sidecar
+// containers (the --sidecars and --sidecar=<name> flags) instead of its
+// steps.
+func filterSidecars(pod *corev1.Pod, allSidecars bool, sidecarsGiven []string) []*step {
+	sidecarsInPod := getSidecars(pod)
+
+	if len(sidecarsGiven) == 0 {
+		if allSidecars {
+			return sidecarsInPod
+		}
+		return nil
+	}
+
+	sidecarsToAdd := map[string]bool{}
+	for _, s := range sidecarsGiven {
+		sidecarsToAdd[s] = true
+	}
+
+	sidecars := []*step{}
+	for _, sc := range sidecarsInPod {
+		if sidecarsToAdd[sc.name] {
+			sidecars = append(sidecars, sc)
+		}
+	}
+
+	return sidecars
+}
+
 func getInitSteps(pod *corev1.Pod) []*step {
 	status := map[string]corev1.ContainerState{}
 	for _, ics := range pod.Status.InitContainerStatuses {
@@ -241,41 +783,82 @@ func getInitSteps(pod *corev1.Pod) []*step {
 	return steps
 }
 
+// getSteps, getSidecars and getResultsContainer each walk pod.Spec.Containers
+// once, classifying every container by name into exactly one of: the
+// reserved results-reporting sidecar, a user sidecar ("sidecar-" prefix),
+// or otherwise a step (with any "step-" prefix stripped from its name). Before sidecars and results existed, every
+// container here was treated as a step.
 func getSteps(pod *corev1.Pod) []*step {
+	steps, _, _ := classifyContainers(pod)
+	return steps
+}
+
+func getSidecars(pod *corev1.Pod) []*step {
+	_, sidecars, _ := classifyContainers(pod)
+	return sidecars
+}
+
+// getResultsContainer returns the name of the pod's results-reporting
+// sidecar, or "" if it doesn't have one.
+func getResultsContainer(pod *corev1.Pod) string {
+	_, _, results := classifyContainers(pod)
+	return results
+}
+
+func classifyContainers(pod *corev1.Pod) (steps, sidecars []*step, resultsContainer string) {
 	status := map[string]corev1.ContainerState{}
 	for _, cs := range pod.Status.ContainerStatuses {
 		status[cs.Name] = cs.State
 	}
 
-	steps := []*step{}
 	for _, c := range pod.Spec.Containers {
-		steps = append(steps, &step{
-			name:      strings.TrimPrefix(c.Name, "step-"),
-			container: c.Name,
-			state:     status[c.Name],
-		})
+		switch {
+		case c.Name == resultsSidecarContainerName:
+			resultsContainer = c.Name
+		case strings.HasPrefix(c.Name, sidecarPrefix):
+			sidecars = append(sidecars, &step{
+				name:      strings.TrimPrefix(c.Name, sidecarPrefix),
+				container: c.Name,
+				state:     status[c.Name],
+			})
+		default:
+			steps = append(steps, &step{
+				name:      strings.TrimPrefix(c.Name, "step-"),
+				container: c.Name,
+				state:     status[c.Name],
+			})
+		}
 	}
 
-	return steps
+	return steps, sidecars, resultsContainer
 }
 
 // Reading of logs should wait until the name of the pod is
 // updated in the status. Open a watch channel on the task run
 // and keep checking the status until the pod name updates
 // or the timeout is reached.
-func (lr *LogReader) waitUntilPodNameAvailable(timeout time.Duration) (*v1alpha1.TaskRun, error) {
-	var first = true
+func (lr *LogReader) waitUntilPodNameAvailable(timeout time.Duration) (*trResource, error) {
 	opts := metav1.ListOptions{
 		FieldSelector: fields.OneTermEqualSelector("metadata.name", lr.Run).String(),
 	}
 	tkn := lr.Clients.Tekton
+
+	if _, err := tkn.TektonV1beta1().TaskRuns(lr.Ns).Get(lr.Run, metav1.GetOptions{}); err == nil {
+		return lr.waitUntilPodNameAvailableV1beta1(timeout, opts)
+	}
+
+	return lr.waitUntilPodNameAvailableV1alpha1(timeout, opts)
+}
+
+func (lr *LogReader) waitUntilPodNameAvailableV1alpha1(timeout time.Duration, opts metav1.ListOptions) (*trResource, error) {
+	tkn := lr.Clients.Tekton
 	run, err := tkn.TektonV1alpha1().TaskRuns(lr.Ns).Get(lr.Run, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
 	if run.Status.PodName != "" {
-		return run, nil
+		return trResourceFromV1alpha1(run), nil
 	}
 
 	watchRun, err := tkn.TektonV1alpha1().TaskRuns(lr.Ns).Watch(opts)
@@ -288,10 +871,43 @@ func (lr *LogReader) waitUntilPodNameAvailable(timeout time.Duration) (*v1alpha1
 			run := event.Object.(*v1alpha1.TaskRun)
 			if run.Status.PodName != "" {
 				watchRun.Stop()
-				return run, nil
+				return trResourceFromV1alpha1(run), nil
+			}
+		case <-time.After(timeout * time.Second):
+			watchRun.Stop()
+
+			//Check if taskrun failed on start up
+			if err = hasTaskRunFailed(run.Status.Conditions, lr.Task); err != nil {
+				return nil, err
 			}
-			if first {
-				first = false
+
+			return nil, fmt.Errorf("task %s create has not started yet or pod for task not yet available", lr.Task)
+		}
+	}
+}
+
+func (lr *LogReader) waitUntilPodNameAvailableV1beta1(timeout time.Duration, opts metav1.ListOptions) (*trResource, error) {
+	tkn := lr.Clients.Tekton
+	run, err := tkn.TektonV1beta1().TaskRuns(lr.Ns).Get(lr.Run, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if run.Status.PodName != "" {
+		return trResourceFromV1beta1(run), nil
+	}
+
+	watchRun, err := tkn.TektonV1beta1().TaskRuns(lr.Ns).Watch(opts)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		select {
+		case event := <-watchRun.ResultChan():
+			run := event.Object.(*v1beta1.TaskRun)
+			if run.Status.PodName != "" {
+				watchRun.Stop()
+				return trResourceFromV1beta1(run), nil
 			}
 		case <-time.After(timeout * time.Second):
 			watchRun.Stop()
@@ -306,7 +922,7 @@ func (lr *LogReader) waitUntilPodNameAvailable(timeout time.Duration) (*v1alpha1
 	}
 }
 
-func hasTaskRunFailed(trConditions v1beta1.Conditions, taskName string) error {
+func hasTaskRunFailed(trConditions duckv1beta1.Conditions, taskName string) error {
 	if len(trConditions) != 0 && trConditions[0].Status == corev1.ConditionFalse {
 		return fmt.Errorf("task %s has failed: %s", taskName, trConditions[0].Message)
 	}
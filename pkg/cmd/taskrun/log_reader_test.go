@@ -0,0 +1,160 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/helper/log"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTrResourceFromV1alpha1(t *testing.T) {
+	tr := &v1alpha1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-abc", Labels: map[string]string{"tekton.dev/pipeline": "build"}},
+		Spec:       v1alpha1.TaskRunSpec{TaskRef: &v1alpha1.TaskRef{Name: "build-task"}},
+		Status:     v1alpha1.TaskRunStatus{TaskRunStatusFields: v1alpha1.TaskRunStatusFields{PodName: "build-abc-pod"}},
+	}
+
+	res := trResourceFromV1alpha1(tr)
+	if res.name != "build-abc" || res.podName != "build-abc-pod" || res.taskRefName != "build-task" {
+		t.Errorf("trResourceFromV1alpha1() = %+v, unexpected fields", res)
+	}
+	if res.labels["tekton.dev/pipeline"] != "build" {
+		t.Errorf("trResourceFromV1alpha1() labels = %v, missing pipeline label", res.labels)
+	}
+}
+
+func TestTrResourceFromV1beta1(t *testing.T) {
+	tr := &v1beta1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-abc", Labels: map[string]string{"tekton.dev/pipeline": "build"}},
+		Spec:       v1beta1.TaskRunSpec{TaskRef: &v1beta1.TaskRef{Name: "build-task"}},
+		Status:     v1beta1.TaskRunStatus{TaskRunStatusFields: v1beta1.TaskRunStatusFields{PodName: "build-abc-pod"}},
+	}
+
+	res := trResourceFromV1beta1(tr)
+	if res.name != "build-abc" || res.podName != "build-abc-pod" || res.taskRefName != "build-task" {
+		t.Errorf("trResourceFromV1beta1() = %+v, unexpected fields", res)
+	}
+}
+
+func TestParseKubeletTimestamp(t *testing.T) {
+	want := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	ts, text := parseKubeletTimestamp(want.Format(time.RFC3339Nano) + " hello world")
+	if !ts.Equal(want) {
+		t.Errorf("parseKubeletTimestamp() ts = %v, want %v", ts, want)
+	}
+	if text != "hello world" {
+		t.Errorf("parseKubeletTimestamp() text = %q, want %q", text, "hello world")
+	}
+
+	// A line without a recognizable timestamp prefix is passed through as-is
+	// with a zero time instead of crashing.
+	ts, text = parseKubeletTimestamp("no timestamp here")
+	if !ts.IsZero() {
+		t.Errorf("parseKubeletTimestamp() ts = %v, want zero", ts)
+	}
+	if text != "no timestamp here" {
+		t.Errorf("parseKubeletTimestamp() text = %q, want %q", text, "no timestamp here")
+	}
+}
+
+func TestClassifyContainers(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "step-build"},
+				{Name: "sidecar-logger"},
+				{Name: resultsSidecarContainerName},
+			},
+		},
+	}
+
+	steps, sidecars, results := classifyContainers(pod)
+	if len(steps) != 1 || steps[0].name != "build" {
+		t.Errorf("classifyContainers() steps = %+v, want [build]", steps)
+	}
+	if len(sidecars) != 1 || sidecars[0].name != "logger" {
+		t.Errorf("classifyContainers() sidecars = %+v, want [logger]", sidecars)
+	}
+	if results != resultsSidecarContainerName {
+		t.Errorf("classifyContainers() results = %q, want %q", results, resultsSidecarContainerName)
+	}
+}
+
+func TestFilterSidecars(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "step-build"},
+				{Name: "sidecar-logger"},
+				{Name: "sidecar-proxy"},
+			},
+		},
+	}
+
+	if got := filterSidecars(pod, false, nil); len(got) != 0 {
+		t.Errorf("filterSidecars(false, nil) = %+v, want none", got)
+	}
+	if got := filterSidecars(pod, true, nil); len(got) != 2 {
+		t.Errorf("filterSidecars(true, nil) = %+v, want both sidecars", got)
+	}
+	if got := filterSidecars(pod, false, []string{"logger"}); len(got) != 1 || got[0].name != "logger" {
+		t.Errorf("filterSidecars(false, [logger]) = %+v, want [logger]", got)
+	}
+}
+
+// TestMergeReorderedLinesEOFOrdering guards against the EOFLOG sentinel
+// sorting ahead of a step's own real lines when it carries a zero
+// timestamp: the merge sorts each batch ascending by timestamp, so a
+// zero-time EOF would jump in front of any real, non-zero-timestamped line
+// landing in the same batch.
+func TestMergeReorderedLinesEOFOrdering(t *testing.T) {
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	linesC := make(chan streamLine)
+	logC := make(chan log.Log)
+
+	go func() {
+		mergeReorderedLines(linesC, logC)
+		close(logC)
+	}()
+
+	go func() {
+		linesC <- streamLine{log: log.Log{Step: "build", Log: "line one"}, seq: 1, ts: base}
+		linesC <- streamLine{log: log.Log{Step: "build", Log: "line two"}, seq: 2, ts: base.Add(time.Second)}
+		linesC <- streamLine{log: log.Log{Step: "build", Log: "EOFLOG"}, seq: 3, ts: base.Add(time.Second)}
+		close(linesC)
+	}()
+
+	var got []string
+	for l := range logC {
+		got = append(got, l.Log)
+	}
+
+	want := []string{"line one", "line two", "EOFLOG"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got[%d] = %q, want %q (EOFLOG must not sort ahead of the step's real lines)", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/helper/log"
+	"github.com/tektoncd/cli/pkg/helper/pods"
+)
+
+// validLogPrefixes are the values accepted by --prefix: how the printer
+// labels interleaved lines from different steps/sidecars.
+var validLogPrefixes = map[string]bool{"none": true, "step": true, "task": true, "full": true}
+
+func logCommand(p cli.Params) *cobra.Command {
+	var allSteps bool
+	var follow bool
+	var steps []string
+	var allSidecars bool
+	var sidecars []string
+	var withConditions bool
+	var prefix string
+
+	eg := `Show the logs of TaskRun named 'foo' from namespace 'bar':
+
+	tkn taskrun logs foo -n bar
+
+or
+
+	tkn tr logs foo -n bar
+`
+
+	c := &cobra.Command{
+		Use:          "logs",
+		Short:        "Show TaskRun logs",
+		Example:      eg,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !validLogPrefixes[prefix] {
+				return fmt.Errorf("invalid --prefix value %q: must be one of none, step, task, full", prefix)
+			}
+
+			cs, err := p.Clients()
+			if err != nil {
+				return fmt.Errorf("failed to create tekton client: %v", err)
+			}
+
+			lr := &LogReader{
+				Run:            args[0],
+				Ns:             p.Namespace(),
+				Clients:        cs,
+				Streamer:       pods.NewStream,
+				Follow:         follow,
+				AllSteps:       allSteps,
+				Steps:          steps,
+				AllSidecars:    allSidecars,
+				Sidecars:       sidecars,
+				WithConditions: withConditions,
+				Prefix:         prefix,
+				Stream: &cli.Stream{
+					Out: cmd.OutOrStdout(),
+					Err: cmd.OutOrStderr(),
+				},
+			}
+
+			logC, errC, err := lr.Read()
+			if err != nil {
+				return fmt.Errorf("failed to get logs for taskrun %s: %v", args[0], err)
+			}
+
+			return printTaskRunLogs(cmd.OutOrStdout(), logC, errC, prefix)
+		},
+	}
+
+	c.Flags().BoolVarP(&allSteps, "all", "a", false, "show all logs including init steps injected by tekton")
+	c.Flags().BoolVarP(&follow, "follow", "f", false, "stream live logs")
+	c.Flags().StringSliceVarP(&steps, "step", "", nil, "show logs for these steps only")
+	c.Flags().BoolVarP(&allSidecars, "sidecars", "", false, "show logs for all sidecar containers")
+	c.Flags().StringSliceVarP(&sidecars, "sidecar", "", nil, "show logs for these sidecars only")
+	c.Flags().BoolVarP(&withConditions, "with-conditions", "", false, "include the logs of the ConditionChecks guarding this TaskRun ahead of its step logs")
+	c.Flags().StringVarP(&prefix, "prefix", "", "task", "how to label interleaved lines: none, step, task or full")
+
+	return c
+}
+
+// printTaskRunLogs drains logC/errC, writing each log line to out labeled
+// per prefix, until both channels close. The EOFLOG sentinel step readers
+// emit to delimit a step's output is swallowed rather than printed.
+func printTaskRunLogs(out io.Writer, logC <-chan log.Log, errC <-chan error, prefix string) error {
+	var lastErr error
+	for logC != nil || errC != nil {
+		select {
+		case l, ok := <-logC:
+			if !ok {
+				logC = nil
+				continue
+			}
+			if l.Log == "EOFLOG" {
+				continue
+			}
+			fmt.Fprintln(out, formatLogLine(prefix, l))
+
+		case e, ok := <-errC:
+			if !ok {
+				errC = nil
+				continue
+			}
+			lastErr = e
+			fmt.Fprintln(out, e)
+		}
+	}
+	return lastErr
+}
+
+// formatLogLine labels a log line per the --prefix value.
+func formatLogLine(prefix string, l log.Log) string {
+	switch prefix {
+	case "none":
+		return l.Log
+	case "step":
+		return fmt.Sprintf("[%s] %s", l.Step, l.Log)
+	case "full":
+		return fmt.Sprintf("[%s : %s] %s", l.Task, l.Step, l.Log)
+	default: // "task"
+		return fmt.Sprintf("[%s] %s", l.Task, l.Log)
+	}
+}
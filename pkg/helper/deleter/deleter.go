@@ -0,0 +1,212 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deleter centralizes the interactive prompt / --force / --all /
+// --dry-run / --related flow that every `tkn <resource> delete` command
+// otherwise reimplements on its own.
+package deleter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DryRunStrategy selects how a delete that is requested via --dry-run is
+// carried out: not at all (the zero value), client-side (no API calls),
+// or server-side (the apiserver validates and reports the result without
+// persisting it, via DeleteOptions.DryRun).
+type DryRunStrategy string
+
+const (
+	// DryRunNone performs the delete for real.
+	DryRunNone DryRunStrategy = ""
+	// DryRunClient prints what would be deleted without calling the API.
+	DryRunClient DryRunStrategy = "client"
+	// DryRunServer asks the apiserver to validate the delete without
+	// persisting it, via metav1.DeleteOptions{DryRun: []string{"All"}}.
+	DryRunServer DryRunStrategy = "server"
+)
+
+// ParseDryRunStrategy validates the string passed to --dry-run.
+func ParseDryRunStrategy(s string) (DryRunStrategy, error) {
+	switch DryRunStrategy(s) {
+	case DryRunNone, DryRunClient, DryRunServer:
+		return DryRunStrategy(s), nil
+	}
+	return DryRunNone, fmt.Errorf("invalid dry-run value %q: must be %q or %q", s, DryRunClient, DryRunServer)
+}
+
+// ListFunc lists the names of every object of the Deleter's resource kind
+// that matches opts in a namespace.
+type ListFunc func(opts metav1.ListOptions) ([]string, error)
+
+// DeleteFunc deletes the named object. opts carries the DryRun setting for
+// server-side dry-run and is nil otherwise.
+type DeleteFunc func(name string, opts *metav1.DeleteOptions) error
+
+// Deleter drives the delete flow shared by every `tkn <resource> delete`
+// command: it only needs to know the resource's kind name and how to list
+// and delete instances of it in a namespace.
+type Deleter struct {
+	// Kind is the lowercase, singular resource name used in prompts and
+	// error messages, e.g. "condition", "pipeline", "task".
+	Kind string
+	// List enumerates every object of Kind in the namespace, used by
+	// --all and by --related to discover children.
+	List ListFunc
+	// Delete removes a single named object of Kind.
+	Delete DeleteFunc
+
+	// DryRun selects whether Delete calls are actually made.
+	DryRun DryRunStrategy
+}
+
+// New returns a Deleter for the given resource kind.
+func New(kind string, list ListFunc, del DeleteFunc) *Deleter {
+	return &Deleter{Kind: kind, List: list, Delete: del}
+}
+
+// deleteOptionsFor returns the DeleteOptions to pass to Delete for the
+// configured DryRunStrategy, or nil when the delete should be persisted.
+func (d *Deleter) deleteOptionsFor() *metav1.DeleteOptions {
+	if d.DryRun != DryRunServer {
+		return nil
+	}
+	return &metav1.DeleteOptions{DryRun: []string{metav1.DryRunAll}}
+}
+
+// DeleteNames deletes each of names, or (when DryRun is set) records that it
+// would have. It returns the names that were actually deleted (or would
+// have been, under a dry run) and, if any delete failed, one error holding
+// every failure, one per line.
+func (d *Deleter) DeleteNames(names []string) ([]string, error) {
+	deleted := make([]string, 0, len(names))
+	var errs []string
+
+	for _, name := range names {
+		if d.DryRun == DryRunClient {
+			deleted = append(deleted, name)
+			continue
+		}
+		if err := d.Delete(name, d.deleteOptionsFor()); err != nil {
+			errs = append(errs, fmt.Sprintf("failed to delete %s %q: %v", d.Kind, name, err))
+			continue
+		}
+		deleted = append(deleted, name)
+	}
+
+	if len(errs) > 0 {
+		return deleted, errors.New(strings.Join(errs, "\n"))
+	}
+	return deleted, nil
+}
+
+// DeleteAll deletes every object of Kind in the namespace, discovered via
+// List, honoring DryRun the same way DeleteNames does.
+func (d *Deleter) DeleteAll(opts metav1.ListOptions) error {
+	names, err := d.List(opts)
+	if err != nil {
+		return fmt.Errorf("failed to list %ss: %v", d.Kind, err)
+	}
+	_, err = d.DeleteNames(names)
+	return err
+}
+
+// PromptMessage renders the "are you sure" prompt for the given names, or
+// the --all namespace-wide variant when names is empty.
+func (d *Deleter) PromptMessage(namespace string, names []string) string {
+	if len(names) == 0 {
+		return fmt.Sprintf("Are you sure you want to delete all %ss in namespace %q (y/n): ", d.Kind, namespace)
+	}
+	return fmt.Sprintf("Are you sure you want to delete %s %s (y/n): ", d.Kind, quoteJoin(names))
+}
+
+// DoneMessage renders the confirmation printed once a delete completes.
+func (d *Deleter) DoneMessage(namespace string, names []string) string {
+	if len(names) == 0 {
+		return fmt.Sprintf("All %ss deleted in namespace %q\n", strings.Title(d.Kind), namespace)
+	}
+	return fmt.Sprintf("%ss deleted: %s\n", strings.Title(d.Kind), quoteJoin(names))
+}
+
+// Confirm reports whether a delete of names (or, when names is empty, of
+// every object in namespace) should proceed: it's always true when force is
+// set or a dry run was requested, and otherwise prompts on cmd's configured
+// input/output streams and waits for a "y"/"yes" reply.
+func (d *Deleter) Confirm(cmd *cobra.Command, namespace string, names []string, force bool) (bool, error) {
+	if force || d.DryRun != DryRunNone {
+		return true, nil
+	}
+	fmt.Fprint(cmd.OutOrStdout(), d.PromptMessage(namespace, names))
+	var reply string
+	if _, err := fmt.Fscan(cmd.InOrStdin(), &reply); err != nil {
+		return false, err
+	}
+	return reply == "y" || reply == "yes", nil
+}
+
+// WithDryRunSuffix marks msg as hypothetical when DryRun requested a preview
+// instead of a real delete.
+func (d *Deleter) WithDryRunSuffix(msg string) string {
+	if d.DryRun == DryRunNone {
+		return msg
+	}
+	return strings.TrimSuffix(msg, "\n") + " (dry run)\n"
+}
+
+func quoteJoin(names []string) string {
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = fmt.Sprintf("%q", n)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// RelatedLabelSelector returns the label selector used to discover the
+// resources owned by the named parent of the given kind, matching the
+// selectors already used by `tkn pipeline describe` and `tkn task describe`
+// ("tekton.dev/pipeline=<name>" and "tekton.dev/task=<name>").
+func RelatedLabelSelector(parentKind, name string) metav1.ListOptions {
+	return metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("tekton.dev/%s=%s", parentKind, name),
+	}
+}
+
+// DeleteRelated deletes the objects a parent resource owns, found via the
+// "tekton.dev/<parentKind>=<name>" label selector (e.g. a Pipeline's
+// PipelineRuns or a Task's TaskRuns), using related's own Deleter.
+func DeleteRelated(related *Deleter, parentKind, name string) error {
+	return related.DeleteAll(RelatedLabelSelector(parentKind, name))
+}
+
+// DeleteRelatedAll calls DeleteRelated for each of names, returning the
+// first error encountered. Used by --related to clean up the children of
+// every parent that was just deleted.
+func DeleteRelatedAll(related *Deleter, parentKind string, names []string) error {
+	for _, name := range names {
+		if err := DeleteRelated(related, parentKind, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stream is an alias of cli.Stream. A Deleter does not print to it: Confirm
+// prompts on the cobra command's own input and output streams.
+type Stream = cli.Stream
@@ -0,0 +1,145 @@
+// Copyright © 2019 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deleter
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func fakeDeleter(existing ...string) (*Deleter, *[]string) {
+	names := append([]string{}, existing...)
+	deleted := make([]string, 0)
+
+	d := &Deleter{
+		Kind: "condition",
+		List: func(metav1.ListOptions) ([]string, error) {
+			return names, nil
+		},
+		Delete: func(name string, opts *metav1.DeleteOptions) error {
+			for _, n := range names {
+				if n == name {
+					deleted = append(deleted, name)
+					return nil
+				}
+			}
+			return fmt.Errorf("conditions.tekton.dev %q not found", name)
+		},
+	}
+	return d, &deleted
+}
+
+func TestDeleteNames(t *testing.T) {
+	d, deleted := fakeDeleter("condition1", "condition2")
+
+	got, err := d.DeleteNames([]string{"condition1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0] != "condition1" {
+		t.Errorf("got %v, want [condition1]", got)
+	}
+	if len(*deleted) != 1 || (*deleted)[0] != "condition1" {
+		t.Errorf("Delete was not invoked for condition1, deleted=%v", *deleted)
+	}
+}
+
+func TestDeleteNamesNotFound(t *testing.T) {
+	d, _ := fakeDeleter("condition1")
+
+	if _, err := d.DeleteNames([]string{"nonexistent"}); err == nil {
+		t.Fatal("expected error deleting a nonexistent condition")
+	}
+}
+
+func TestDeleteNamesDryRunClient(t *testing.T) {
+	d, deleted := fakeDeleter("condition1")
+	d.DryRun = DryRunClient
+
+	got, err := d.DeleteNames([]string{"condition1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0] != "condition1" {
+		t.Errorf("got %v, want [condition1]", got)
+	}
+	if len(*deleted) != 0 {
+		t.Errorf("client dry-run should not call Delete, got %v", *deleted)
+	}
+}
+
+func TestDeleteAll(t *testing.T) {
+	d, deleted := fakeDeleter("condition1", "condition2", "condition3")
+
+	if err := d.DeleteAll(metav1.ListOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*deleted) != 3 {
+		t.Errorf("got %v deleted, want all 3", *deleted)
+	}
+}
+
+func TestParseDryRunStrategy(t *testing.T) {
+	for _, tc := range []struct {
+		in      string
+		want    DryRunStrategy
+		wantErr bool
+	}{
+		{"", DryRunNone, false},
+		{"client", DryRunClient, false},
+		{"server", DryRunServer, false},
+		{"bogus", DryRunNone, true},
+	} {
+		got, err := ParseDryRunStrategy(tc.in)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("ParseDryRunStrategy(%q): expected error", tc.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseDryRunStrategy(%q): unexpected error: %v", tc.in, err)
+		}
+		if got != tc.want {
+			t.Errorf("ParseDryRunStrategy(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestPromptAndDoneMessages(t *testing.T) {
+	d := &Deleter{Kind: "condition"}
+
+	if got, want := d.PromptMessage("ns", []string{"condition1"}), `Are you sure you want to delete condition "condition1" (y/n): `; got != want {
+		t.Errorf("PromptMessage() = %q, want %q", got, want)
+	}
+	if got, want := d.PromptMessage("ns", nil), `Are you sure you want to delete all conditions in namespace "ns" (y/n): `; got != want {
+		t.Errorf("PromptMessage() = %q, want %q", got, want)
+	}
+	if got, want := d.DoneMessage("ns", []string{"condition1", "condition2"}), "Conditions deleted: \"condition1\", \"condition2\"\n"; got != want {
+		t.Errorf("DoneMessage() = %q, want %q", got, want)
+	}
+	if got, want := d.DoneMessage("ns", nil), "All Conditions deleted in namespace \"ns\"\n"; got != want {
+		t.Errorf("DoneMessage() = %q, want %q", got, want)
+	}
+}
+
+func TestRelatedLabelSelector(t *testing.T) {
+	got := RelatedLabelSelector("pipeline", "build")
+	if want := "tekton.dev/pipeline=build"; got.LabelSelector != want {
+		t.Errorf("RelatedLabelSelector().LabelSelector = %q, want %q", got.LabelSelector, want)
+	}
+}